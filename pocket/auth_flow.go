@@ -0,0 +1,193 @@
+package pocket
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+const defaultSuccessHTML = `<html><body>Authorization complete. You can close this tab.</body></html>`
+
+// AuthFlow runs the Pocket OAuth dance end-to-end: it requests a token,
+// opens the authorization URL in the user's browser, and runs a local
+// callback server to catch the redirect, rather than requiring the caller
+// to hand-roll one (see main.go's fmt.Scanln-based flow for the manual
+// alternative).
+type AuthFlow struct {
+	client      *Client
+	addr        string
+	redirectURL string
+	successHTML string
+}
+
+// AuthFlowOption configures an AuthFlow constructed by NewAuthFlow.
+type AuthFlowOption func(*AuthFlow)
+
+// WithSuccessHTML overrides the HTML page served to the browser once the
+// callback has been handled.
+func WithSuccessHTML(html string) AuthFlowOption {
+	return func(f *AuthFlow) {
+		f.successHTML = html
+	}
+}
+
+// NewAuthFlow creates an AuthFlow that listens on addr and expects Pocket
+// to redirect back to redirectURL once the user authorizes the app.
+// redirectURL's host and port should match addr.
+func NewAuthFlow(client *Client, addr, redirectURL string, opts ...AuthFlowOption) *AuthFlow {
+	f := &AuthFlow{
+		client:      client,
+		addr:        addr,
+		redirectURL: redirectURL,
+		successHTML: defaultSuccessHTML,
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
+
+// Login runs the full authorization dance: it obtains a request token,
+// opens the authorization URL in the user's browser, waits for Pocket to
+// redirect back to the local callback server, and exchanges the result for
+// an access token.
+func (f *AuthFlow) Login(ctx context.Context) (*AuthorizationResponse, error) {
+	state, err := randomState()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to generate state token")
+	}
+
+	redirectURL, err := withStateParam(f.redirectURL, state)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to build redirect url")
+	}
+
+	requestToken, err := f.client.GetRequestToken(ctx, redirectURL)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get request token")
+	}
+
+	authURL, err := f.client.GetAuthorizationURL(requestToken, redirectURL)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to build authorization url")
+	}
+
+	callbackPath, err := urlPath(f.redirectURL)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to parse redirect url")
+	}
+
+	callbackErr := make(chan error, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != state {
+			http.Error(w, "invalid state", http.StatusBadRequest)
+			callbackErr <- errors.New("state mismatch in oauth callback")
+			return
+		}
+
+		fmt.Fprint(w, f.successHTML)
+		callbackErr <- nil
+	})
+
+	listener, err := net.Listen("tcp", f.addr)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to listen for oauth callback")
+	}
+
+	server := &http.Server{Addr: f.addr, Handler: mux}
+	serveErr := make(chan error, 1)
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			serveErr <- err
+		}
+	}()
+	defer server.Close()
+
+	if err := openBrowser(authURL); err != nil {
+		return nil, errors.WithMessage(err, "failed to open browser")
+	}
+
+	select {
+	case err := <-callbackErr:
+		if err != nil {
+			return nil, err
+		}
+	case err := <-serveErr:
+		return nil, errors.WithMessage(err, "callback server failed")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return f.client.Authorize(ctx, requestToken)
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func withStateParam(rawURL, state string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func urlPath(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Path == "" {
+		return "/", nil
+	}
+
+	return u.Path, nil
+}
+
+// openBrowser opens url in the user's default browser, using the
+// platform-appropriate launcher.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	// Reap the child once it exits so it doesn't linger as a zombie process.
+	go cmd.Wait()
+
+	return nil
+}
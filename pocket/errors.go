@@ -0,0 +1,65 @@
+package pocket
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Pocket's X-Error-Code values for conditions callers commonly need to
+// branch on. See https://getpocket.com/developer/docs/errors for the full
+// table.
+const (
+	errorCodeInvalidConsumerKey = 152
+	errorCodeInvalidRedirect    = 157
+	errorCodeInvalidAccessToken = 158
+	errorCodePermissionDenied   = 180
+	errorCodeRateLimited        = 190
+)
+
+// APIError is returned whenever the Pocket API responds with a non-200
+// status. It captures the HTTP status and the X-Error/X-Error-Code headers
+// Pocket uses to describe what went wrong, so callers can distinguish
+// "token expired, re-auth" from "network hiccup, retry" from "malformed
+// request".
+type APIError struct {
+	StatusCode int
+	ErrorCode  int
+	Message    string
+	Endpoint   string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("pocket: %s: %s (status %d, error code %d)", e.Endpoint, e.Message, e.StatusCode, e.ErrorCode)
+}
+
+// Is lets errors.Is match an APIError against one of the sentinel errors
+// below by comparing their ErrorCode.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+
+	return e.ErrorCode == t.ErrorCode
+}
+
+// Sentinel errors for the X-Error-Code conditions callers most commonly
+// need to handle. Match them with errors.Is, e.g.
+// errors.Is(err, pocket.ErrInvalidAccessToken).
+var (
+	ErrInvalidConsumerKey = &APIError{ErrorCode: errorCodeInvalidConsumerKey}
+	ErrInvalidRedirect    = &APIError{ErrorCode: errorCodeInvalidRedirect}
+	ErrInvalidAccessToken = &APIError{ErrorCode: errorCodeInvalidAccessToken}
+	ErrPermissionDenied   = &APIError{ErrorCode: errorCodePermissionDenied}
+	ErrRateLimited        = &APIError{ErrorCode: errorCodeRateLimited}
+)
+
+// newAPIError builds an APIError from a non-200 response's headers.
+func newAPIError(endpoint string, resp *http.Response) *APIError {
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		ErrorCode:  parseHeaderInt(resp.Header.Get(xErrorCodeHeader)),
+		Message:    resp.Header.Get(xErrorHeader),
+		Endpoint:   endpoint,
+	}
+}
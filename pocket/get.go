@@ -0,0 +1,323 @@
+package pocket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Pocket's documented values for GetInput.State.
+const (
+	StateUnread  = "unread"
+	StateArchive = "archive"
+	StateAll     = "all"
+)
+
+// Pocket's documented values for GetInput.ContentType.
+const (
+	ContentTypeArticle = "article"
+	ContentTypeVideo   = "video"
+	ContentTypeImage   = "image"
+)
+
+// Pocket's documented values for GetInput.Sort.
+const (
+	SortNewest = "newest"
+	SortOldest = "oldest"
+	SortTitle  = "title"
+	SortSite   = "site"
+)
+
+// Pocket's documented values for GetInput.DetailType.
+const (
+	DetailTypeSimple   = "simple"
+	DetailTypeComplete = "complete"
+)
+
+// TagUntagged is the sentinel value accepted by GetInput.Tag to select
+// items that have no tags at all.
+const TagUntagged = "_untagged_"
+
+const defaultPageSize = 30
+
+// GetInput holds the filter and pagination parameters accepted by the
+// /v3/get endpoint.
+type GetInput struct {
+	AccessToken string
+
+	State       string
+	Favorite    *bool
+	Tag         string
+	ContentType string
+	Sort        string
+	DetailType  string
+	Search      string
+	Domain      string
+	Since       int64 // Unix time; only items modified since this time are returned
+
+	Count  int
+	Offset int
+}
+
+func (i GetInput) validate() error {
+	if i.AccessToken == "" {
+		return errors.New("access token is empty")
+	}
+
+	return nil
+}
+
+type getRequest struct {
+	ConsumerKey string `json:"consumer_key"`
+	AccessToken string `json:"access_token"`
+
+	State       string `json:"state,omitempty"`
+	Favorite    string `json:"favorite,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Sort        string `json:"sort,omitempty"`
+	DetailType  string `json:"detailType,omitempty"`
+	Search      string `json:"search,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	Since       string `json:"since,omitempty"`
+
+	Count  int `json:"count,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
+
+func (i GetInput) generateRequest(consumerKey string) getRequest {
+	req := getRequest{
+		ConsumerKey: consumerKey,
+		AccessToken: i.AccessToken,
+		State:       i.State,
+		Tag:         i.Tag,
+		ContentType: i.ContentType,
+		Sort:        i.Sort,
+		DetailType:  i.DetailType,
+		Search:      i.Search,
+		Domain:      i.Domain,
+		Count:       i.Count,
+		Offset:      i.Offset,
+	}
+
+	if i.Favorite != nil {
+		if *i.Favorite {
+			req.Favorite = "1"
+		} else {
+			req.Favorite = "0"
+		}
+	}
+
+	if i.Since > 0 {
+		req.Since = strconv.FormatInt(i.Since, 10)
+	}
+
+	return req
+}
+
+// Tag is a user-applied label attached to an Item.
+type Tag struct {
+	Item string `json:"item_id"`
+	Tag  string `json:"tag"`
+}
+
+// Author is a content author as resolved by Pocket's parser.
+type Author struct {
+	AuthorID string `json:"author_id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+}
+
+// Image is an image discovered in an item's content.
+type Image struct {
+	ImageID string `json:"image_id"`
+	Src     string `json:"src"`
+	Width   string `json:"width"`
+	Height  string `json:"height"`
+}
+
+// Video is a video discovered in an item's content.
+type Video struct {
+	VideoID string `json:"video_id"`
+	Src     string `json:"src"`
+	Type    string `json:"type"`
+	Vid     string `json:"vid"`
+}
+
+// Item represents a single saved Pocket item as returned by /v3/get. Pocket
+// returns several numeric fields (word count, timestamps) as strings, so
+// this mirrors the wire format rather than converting them.
+type Item struct {
+	ItemID      string `json:"item_id"`
+	ResolvedURL string `json:"resolved_url"`
+	GivenTitle  string `json:"given_title"`
+	Excerpt     string `json:"excerpt"`
+	WordCount   string `json:"word_count"`
+	TimeAdded   string `json:"time_added"`
+
+	Tags    map[string]Tag    `json:"tags"`
+	Authors map[string]Author `json:"authors"`
+	Images  map[string]Image  `json:"images"`
+	Videos  map[string]Video  `json:"videos"`
+}
+
+// UnmarshalJSON handles Pocket's quirk, inherited from PHP's json_encode,
+// of encoding an empty tags/authors/images/videos map as a JSON array
+// ([]) instead of an empty object.
+func (it *Item) UnmarshalJSON(data []byte) error {
+	type alias Item
+	aux := &struct {
+		Tags    json.RawMessage `json:"tags"`
+		Authors json.RawMessage `json:"authors"`
+		Images  json.RawMessage `json:"images"`
+		Videos  json.RawMessage `json:"videos"`
+		*alias
+	}{
+		alias: (*alias)(it),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if err := unmarshalPocketMap(aux.Tags, &it.Tags); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal tags")
+	}
+
+	if err := unmarshalPocketMap(aux.Authors, &it.Authors); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal authors")
+	}
+
+	if err := unmarshalPocketMap(aux.Images, &it.Images); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal images")
+	}
+
+	if err := unmarshalPocketMap(aux.Videos, &it.Videos); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal videos")
+	}
+
+	return nil
+}
+
+// GetResponse is the decoded body of a /v3/get call. list is keyed by
+// item_id, per Pocket's response format.
+type GetResponse struct {
+	Status   int             `json:"status"`
+	Complete int             `json:"complete"`
+	List     map[string]Item `json:"list"`
+	Since    int64           `json:"since"`
+}
+
+// UnmarshalJSON handles Pocket's quirk of encoding an empty list as a JSON
+// array ([]) instead of an empty object, which otherwise happens on every
+// request that matches no items and on the final page of GetAll's paging.
+func (r *GetResponse) UnmarshalJSON(data []byte) error {
+	type alias GetResponse
+	aux := &struct {
+		List json.RawMessage `json:"list"`
+		*alias
+	}{
+		alias: (*alias)(r),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if err := unmarshalPocketMap(aux.List, &r.List); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal list")
+	}
+
+	return nil
+}
+
+// unmarshalPocketMap decodes data into out, a pointer to a map, except
+// when data is an empty JSON array ([]) or absent, in which case out is
+// left as its nil zero value. Pocket's API (being backed by PHP) encodes
+// empty associative arrays as [] rather than {}.
+func unmarshalPocketMap(data []byte, out interface{}) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || string(trimmed) == "null" || string(trimmed) == "[]" {
+		return nil
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// Get retrieves items from a user's Pocket list using the given filters.
+func (c *Client) Get(ctx context.Context, input GetInput) (*GetResponse, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	req := input.generateRequest(c.consumerKey)
+
+	var resp GetResponse
+	if err := c.doJSON(ctx, endpointGet, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ItemPage is a single page of results produced by GetAll. Err is set, and
+// Items left empty, when a request fails mid-stream.
+type ItemPage struct {
+	Items []Item
+	Err   error
+}
+
+// GetAll pages through a user's Pocket list, issuing successive Get calls
+// with increasing offsets until the API returns an empty page. Results are
+// streamed to the returned channel, which is closed once paging completes,
+// a request fails, or ctx is cancelled.
+func (c *Client) GetAll(ctx context.Context, input GetInput) <-chan ItemPage {
+	out := make(chan ItemPage)
+
+	count := input.Count
+	if count <= 0 {
+		count = defaultPageSize
+	}
+
+	go func() {
+		defer close(out)
+
+		offset := input.Offset
+		for {
+			pageInput := input
+			pageInput.Count = count
+			pageInput.Offset = offset
+
+			resp, err := c.Get(ctx, pageInput)
+			if err != nil {
+				select {
+				case out <- ItemPage{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			if len(resp.List) == 0 {
+				return
+			}
+
+			items := make([]Item, 0, len(resp.List))
+			for _, item := range resp.List {
+				items = append(items, item)
+			}
+
+			select {
+			case out <- ItemPage{Items: items}:
+			case <-ctx.Done():
+				return
+			}
+
+			offset += len(resp.List)
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,120 @@
+package pocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientGetEmptyList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":1,"complete":1,"list":[],"since":123}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("consumer-key", WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	resp, err := client.Get(context.Background(), GetInput{AccessToken: "token"})
+	if err != nil {
+		t.Fatalf("Get() error = %v, want nil", err)
+	}
+
+	if len(resp.List) != 0 {
+		t.Errorf("List = %#v, want empty", resp.List)
+	}
+
+	if resp.Since != 123 {
+		t.Errorf("Since = %d, want 123", resp.Since)
+	}
+}
+
+func TestClientGetItemWithEmptyNestedMaps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":1,"complete":1,"list":{"123":{"item_id":"123","given_title":"Example","tags":[],"authors":[],"images":[],"videos":[]}},"since":1}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("consumer-key", WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	resp, err := client.Get(context.Background(), GetInput{AccessToken: "token"})
+	if err != nil {
+		t.Fatalf("Get() error = %v, want nil", err)
+	}
+
+	item, ok := resp.List["123"]
+	if !ok {
+		t.Fatalf("List missing item 123: %#v", resp.List)
+	}
+
+	if item.GivenTitle != "Example" {
+		t.Errorf("GivenTitle = %q, want %q", item.GivenTitle, "Example")
+	}
+
+	if len(item.Tags) != 0 || len(item.Authors) != 0 || len(item.Images) != 0 || len(item.Videos) != 0 {
+		t.Errorf("expected all nested maps empty, got %#v", item)
+	}
+}
+
+func TestClientGetAllStopsOnEmptyPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req getRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if req.Offset == 0 {
+			fmt.Fprint(w, `{"status":1,"complete":1,"list":{"1":{"item_id":"1"},"2":{"item_id":"2"}},"since":1}`)
+			return
+		}
+
+		// Final page: Pocket encodes the now-empty list as [] rather than {}.
+		fmt.Fprint(w, `{"status":1,"complete":1,"list":[],"since":1}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("consumer-key", WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var pages []ItemPage
+	for page := range client.GetAll(context.Background(), GetInput{AccessToken: "token", Count: 2}) {
+		pages = append(pages, page)
+	}
+
+	if len(pages) != 1 {
+		t.Fatalf("got %d pages, want 1", len(pages))
+	}
+
+	if pages[0].Err != nil {
+		t.Errorf("pages[0].Err = %v, want nil", pages[0].Err)
+	}
+
+	if len(pages[0].Items) != 2 {
+		t.Errorf("pages[0].Items has %d items, want 2", len(pages[0].Items))
+	}
+}
+
+func TestGetValidation(t *testing.T) {
+	client, err := NewClient("consumer-key")
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.Get(context.Background(), GetInput{}); err == nil {
+		t.Error("Get() with empty access token: error = nil, want error")
+	}
+}
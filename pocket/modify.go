@@ -0,0 +1,323 @@
+package pocket
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Action is implemented by every typed action that can be submitted to
+// /v3/send as part of a Modify call.
+type Action interface {
+	marshalAction() map[string]interface{}
+}
+
+// ArchiveAction marks an item as archived.
+type ArchiveAction struct {
+	ItemID string
+}
+
+func (a ArchiveAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "archive",
+		"item_id": a.ItemID,
+	}
+}
+
+// ReaddAction re-adds an item to the list, un-archiving it.
+type ReaddAction struct {
+	ItemID string
+}
+
+func (a ReaddAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "readd",
+		"item_id": a.ItemID,
+	}
+}
+
+// FavoriteAction marks an item as a favorite.
+type FavoriteAction struct {
+	ItemID string
+}
+
+func (a FavoriteAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "favorite",
+		"item_id": a.ItemID,
+	}
+}
+
+// UnfavoriteAction removes an item's favorite status.
+type UnfavoriteAction struct {
+	ItemID string
+}
+
+func (a UnfavoriteAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "unfavorite",
+		"item_id": a.ItemID,
+	}
+}
+
+// DeleteAction permanently removes an item from the list.
+type DeleteAction struct {
+	ItemID string
+}
+
+func (a DeleteAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "delete",
+		"item_id": a.ItemID,
+	}
+}
+
+// TagsAddAction adds one or more tags to an item, leaving existing tags
+// untouched.
+type TagsAddAction struct {
+	ItemID string
+	Tags   []string
+}
+
+func (a TagsAddAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "tags_add",
+		"item_id": a.ItemID,
+		"tags":    strings.Join(a.Tags, ","),
+	}
+}
+
+// TagsRemoveAction removes one or more tags from an item.
+type TagsRemoveAction struct {
+	ItemID string
+	Tags   []string
+}
+
+func (a TagsRemoveAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "tags_remove",
+		"item_id": a.ItemID,
+		"tags":    strings.Join(a.Tags, ","),
+	}
+}
+
+// TagsReplaceAction replaces all of an item's tags with the given set.
+type TagsReplaceAction struct {
+	ItemID string
+	Tags   []string
+}
+
+func (a TagsReplaceAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "tags_replace",
+		"item_id": a.ItemID,
+		"tags":    strings.Join(a.Tags, ","),
+	}
+}
+
+// TagsClearAction removes all tags from an item.
+type TagsClearAction struct {
+	ItemID string
+}
+
+func (a TagsClearAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "tags_clear",
+		"item_id": a.ItemID,
+	}
+}
+
+// TagRenameAction renames a tag across a user's whole list.
+type TagRenameAction struct {
+	OldTag string
+	NewTag string
+}
+
+func (a TagRenameAction) marshalAction() map[string]interface{} {
+	return map[string]interface{}{
+		"action":  "tag_rename",
+		"old_tag": a.OldTag,
+		"new_tag": a.NewTag,
+	}
+}
+
+// AddAction saves a new item as part of a batch, mirroring AddInput.
+type AddAction struct {
+	ItemID string
+	URL    string
+	Title  string
+	Tags   []string
+}
+
+func (a AddAction) marshalAction() map[string]interface{} {
+	m := map[string]interface{}{
+		"action": "add",
+		"url":    a.URL,
+	}
+
+	if a.ItemID != "" {
+		m["item_id"] = a.ItemID
+	}
+
+	if a.Title != "" {
+		m["title"] = a.Title
+	}
+
+	if len(a.Tags) > 0 {
+		m["tags"] = strings.Join(a.Tags, ",")
+	}
+
+	return m
+}
+
+// Batch builds up a slice of Actions for a single Modify call using
+// fluent chaining.
+type Batch struct {
+	actions []Action
+}
+
+// NewBatch creates an empty Batch.
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// Archive appends an ArchiveAction.
+func (b *Batch) Archive(itemID string) *Batch {
+	b.actions = append(b.actions, ArchiveAction{ItemID: itemID})
+	return b
+}
+
+// Readd appends a ReaddAction.
+func (b *Batch) Readd(itemID string) *Batch {
+	b.actions = append(b.actions, ReaddAction{ItemID: itemID})
+	return b
+}
+
+// Favorite appends a FavoriteAction.
+func (b *Batch) Favorite(itemID string) *Batch {
+	b.actions = append(b.actions, FavoriteAction{ItemID: itemID})
+	return b
+}
+
+// Unfavorite appends an UnfavoriteAction.
+func (b *Batch) Unfavorite(itemID string) *Batch {
+	b.actions = append(b.actions, UnfavoriteAction{ItemID: itemID})
+	return b
+}
+
+// Delete appends a DeleteAction.
+func (b *Batch) Delete(itemID string) *Batch {
+	b.actions = append(b.actions, DeleteAction{ItemID: itemID})
+	return b
+}
+
+// TagsAdd appends a TagsAddAction.
+func (b *Batch) TagsAdd(itemID string, tags ...string) *Batch {
+	b.actions = append(b.actions, TagsAddAction{ItemID: itemID, Tags: tags})
+	return b
+}
+
+// TagsRemove appends a TagsRemoveAction.
+func (b *Batch) TagsRemove(itemID string, tags ...string) *Batch {
+	b.actions = append(b.actions, TagsRemoveAction{ItemID: itemID, Tags: tags})
+	return b
+}
+
+// TagsReplace appends a TagsReplaceAction.
+func (b *Batch) TagsReplace(itemID string, tags ...string) *Batch {
+	b.actions = append(b.actions, TagsReplaceAction{ItemID: itemID, Tags: tags})
+	return b
+}
+
+// TagsClear appends a TagsClearAction.
+func (b *Batch) TagsClear(itemID string) *Batch {
+	b.actions = append(b.actions, TagsClearAction{ItemID: itemID})
+	return b
+}
+
+// TagRename appends a TagRenameAction.
+func (b *Batch) TagRename(oldTag, newTag string) *Batch {
+	b.actions = append(b.actions, TagRenameAction{OldTag: oldTag, NewTag: newTag})
+	return b
+}
+
+// Add appends an AddAction.
+func (b *Batch) Add(action AddAction) *Batch {
+	b.actions = append(b.actions, action)
+	return b
+}
+
+// Actions returns the accumulated actions, ready to pass to Modify.
+func (b *Batch) Actions() []Action {
+	return b.actions
+}
+
+// ModifyInput holds the batch of actions to submit to /v3/send.
+type ModifyInput struct {
+	AccessToken string
+	Actions     []Action
+}
+
+func (i ModifyInput) validate() error {
+	if i.AccessToken == "" {
+		return errors.New("access token is empty")
+	}
+
+	if len(i.Actions) == 0 {
+		return errors.New("at least one action is required")
+	}
+
+	return nil
+}
+
+type sendRequest struct {
+	ConsumerKey string                   `json:"consumer_key"`
+	AccessToken string                   `json:"access_token"`
+	Actions     []map[string]interface{} `json:"actions"`
+}
+
+// ModifyResponse is the decoded body of a /v3/send call. ActionResults
+// holds one success flag per action, in the same order the actions were
+// submitted; ActionErrors holds the corresponding error message for any
+// action that failed, with other indices left nil.
+type ModifyResponse struct {
+	Status        int       `json:"status"`
+	ActionResults []bool    `json:"action_results"`
+	ActionErrors  []*string `json:"action_errors,omitempty"`
+}
+
+// Succeeded reports whether the action at the given index (matching the
+// order passed to Modify) was applied successfully.
+func (r *ModifyResponse) Succeeded(i int) bool {
+	if i < 0 || i >= len(r.ActionResults) {
+		return false
+	}
+
+	return r.ActionResults[i]
+}
+
+// Modify submits a batch of actions to /v3/send, letting callers archive,
+// favorite, tag, or delete many items in a single request.
+func (c *Client) Modify(ctx context.Context, input ModifyInput) (*ModifyResponse, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	req := sendRequest{
+		ConsumerKey: c.consumerKey,
+		AccessToken: input.AccessToken,
+		Actions:     make([]map[string]interface{}, 0, len(input.Actions)),
+	}
+
+	for _, action := range input.Actions {
+		req.Actions = append(req.Actions, action.marshalAction())
+	}
+
+	var resp ModifyResponse
+	if err := c.doJSON(ctx, endpointSend, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,189 @@
+package pocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestActionsMarshalAction(t *testing.T) {
+	cases := []struct {
+		name   string
+		action Action
+		want   map[string]interface{}
+	}{
+		{
+			name:   "archive",
+			action: ArchiveAction{ItemID: "1"},
+			want:   map[string]interface{}{"action": "archive", "item_id": "1"},
+		},
+		{
+			name:   "readd",
+			action: ReaddAction{ItemID: "1"},
+			want:   map[string]interface{}{"action": "readd", "item_id": "1"},
+		},
+		{
+			name:   "favorite",
+			action: FavoriteAction{ItemID: "1"},
+			want:   map[string]interface{}{"action": "favorite", "item_id": "1"},
+		},
+		{
+			name:   "unfavorite",
+			action: UnfavoriteAction{ItemID: "1"},
+			want:   map[string]interface{}{"action": "unfavorite", "item_id": "1"},
+		},
+		{
+			name:   "delete",
+			action: DeleteAction{ItemID: "1"},
+			want:   map[string]interface{}{"action": "delete", "item_id": "1"},
+		},
+		{
+			name:   "tags_add",
+			action: TagsAddAction{ItemID: "1", Tags: []string{"a", "b"}},
+			want:   map[string]interface{}{"action": "tags_add", "item_id": "1", "tags": "a,b"},
+		},
+		{
+			name:   "tags_remove",
+			action: TagsRemoveAction{ItemID: "1", Tags: []string{"a"}},
+			want:   map[string]interface{}{"action": "tags_remove", "item_id": "1", "tags": "a"},
+		},
+		{
+			name:   "tags_replace",
+			action: TagsReplaceAction{ItemID: "1", Tags: []string{"a", "b"}},
+			want:   map[string]interface{}{"action": "tags_replace", "item_id": "1", "tags": "a,b"},
+		},
+		{
+			name:   "tags_clear",
+			action: TagsClearAction{ItemID: "1"},
+			want:   map[string]interface{}{"action": "tags_clear", "item_id": "1"},
+		},
+		{
+			name:   "tag_rename",
+			action: TagRenameAction{OldTag: "old", NewTag: "new"},
+			want:   map[string]interface{}{"action": "tag_rename", "old_tag": "old", "new_tag": "new"},
+		},
+		{
+			name:   "add",
+			action: AddAction{URL: "https://example.com", Title: "title", Tags: []string{"x"}},
+			want:   map[string]interface{}{"action": "add", "url": "https://example.com", "title": "title", "tags": "x"},
+		},
+		{
+			name:   "add minimal",
+			action: AddAction{URL: "https://example.com"},
+			want:   map[string]interface{}{"action": "add", "url": "https://example.com"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.action.marshalAction()
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("marshalAction() = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBatchActions(t *testing.T) {
+	b := NewBatch().
+		Archive("1").
+		Favorite("2").
+		TagsAdd("3", "a", "b").
+		TagRename("old", "new").
+		Add(AddAction{URL: "https://example.com"})
+
+	want := []Action{
+		ArchiveAction{ItemID: "1"},
+		FavoriteAction{ItemID: "2"},
+		TagsAddAction{ItemID: "3", Tags: []string{"a", "b"}},
+		TagRenameAction{OldTag: "old", NewTag: "new"},
+		AddAction{URL: "https://example.com"},
+	}
+
+	if got := b.Actions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions() = %#v, want %#v", got, want)
+	}
+}
+
+func TestClientModify(t *testing.T) {
+	var gotBody sendRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":1,"action_results":[true,false],"action_errors":[null,"item not found"]}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("consumer-key", WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	input := ModifyInput{
+		AccessToken: "access-token",
+		Actions: []Action{
+			ArchiveAction{ItemID: "1"},
+			DeleteAction{ItemID: "2"},
+		},
+	}
+
+	resp, err := client.Modify(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Modify() error = %v", err)
+	}
+
+	if len(gotBody.Actions) != 2 {
+		t.Fatalf("server received %d actions, want 2", len(gotBody.Actions))
+	}
+
+	if gotBody.Actions[0]["action"] != "archive" || gotBody.Actions[0]["item_id"] != "1" {
+		t.Errorf("unexpected first action sent to server: %#v", gotBody.Actions[0])
+	}
+
+	if gotBody.Actions[1]["action"] != "delete" || gotBody.Actions[1]["item_id"] != "2" {
+		t.Errorf("unexpected second action sent to server: %#v", gotBody.Actions[1])
+	}
+
+	if !resp.Succeeded(0) {
+		t.Errorf("Succeeded(0) = false, want true")
+	}
+
+	if resp.Succeeded(1) {
+		t.Errorf("Succeeded(1) = true, want false")
+	}
+
+	if resp.Succeeded(2) {
+		t.Errorf("Succeeded(2) = true, want false for out-of-range index")
+	}
+
+	if len(resp.ActionErrors) != 2 || resp.ActionErrors[0] != nil {
+		t.Errorf("unexpected ActionErrors[0]: %#v", resp.ActionErrors)
+	}
+
+	if resp.ActionErrors[1] == nil || *resp.ActionErrors[1] != "item not found" {
+		t.Errorf("unexpected ActionErrors[1]: %#v", resp.ActionErrors)
+	}
+}
+
+func TestModifyValidation(t *testing.T) {
+	client, err := NewClient("consumer-key")
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.Modify(context.Background(), ModifyInput{Actions: []Action{ArchiveAction{ItemID: "1"}}}); err == nil {
+		t.Error("Modify() with empty access token: error = nil, want error")
+	}
+
+	if _, err := client.Modify(context.Background(), ModifyInput{AccessToken: "token"}); err == nil {
+		t.Error("Modify() with no actions: error = nil, want error")
+	}
+}
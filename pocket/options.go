@@ -0,0 +1,111 @@
+package pocket
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultUserAgent = "getpocket-go-sdk/1.0"
+
+	headerRetryAfter         = "Retry-After"
+	headerLimitUserLimit     = "X-Limit-User-Limit"
+	headerLimitUserRemaining = "X-Limit-User-Remaining"
+	headerLimitUserReset     = "X-Limit-User-Reset"
+	headerLimitKeyLimit      = "X-Limit-Key-Limit"
+	headerLimitKeyRemaining  = "X-Limit-Key-Remaining"
+	headerLimitKeyReset      = "X-Limit-Key-Reset"
+)
+
+// defaultRetryPolicy retries transient rate-limit/availability errors three
+// times with exponential backoff, capped at 10 seconds.
+var defaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
+}
+
+// Option configures a Client constructed by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the *http.Client used for all requests, e.g. to
+// route through a corporate proxy, attach instrumentation, or point at an
+// httptest server.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.client = httpClient
+	}
+}
+
+// WithTimeout overrides the client's request timeout, which otherwise
+// defaults to 5 seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.client.Timeout = timeout
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithBaseURL overrides the Pocket API base URL, e.g. to point at a test
+// double.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithRetry overrides the policy used to retry rate-limited (429) and
+// unavailable (503) responses.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// RetryPolicy controls how the client retries 429/503 responses.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts after the first.
+	// Zero disables retrying.
+	MaxRetries int
+	// BaseDelay is the backoff used for the first retry, doubled on each
+	// subsequent attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff.
+	MaxDelay time.Duration
+}
+
+// nextDelay returns how long to wait before the given retry attempt
+// (0-indexed). It honors a Retry-After header value, in seconds, when the
+// server supplies one; otherwise it falls back to exponential backoff.
+func (p RetryPolicy) nextDelay(attempt int, retryAfter string) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	delay := p.BaseDelay * time.Duration(math.Pow(2, float64(attempt)))
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+
+	return delay
+}
+
+// RateLimit holds the rate-limit values Pocket reports via X-Limit-*
+// response headers.
+type RateLimit struct {
+	UserLimit     int
+	UserRemaining int
+	UserReset     int
+
+	KeyLimit     int
+	KeyRemaining int
+	KeyReset     int
+}
@@ -0,0 +1,122 @@
+package pocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyNextDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	cases := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		want       time.Duration
+	}{
+		{"first attempt backoff", 0, "", 100 * time.Millisecond},
+		{"second attempt backoff doubles", 1, "", 200 * time.Millisecond},
+		{"third attempt capped at max", 2, "", 300 * time.Millisecond},
+		{"retry-after header overrides backoff", 0, "1", time.Second},
+		{"invalid retry-after falls back to backoff", 0, "not-a-number", 100 * time.Millisecond},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policy.nextDelay(tc.attempt, tc.retryAfter); got != tc.want {
+				t.Errorf("nextDelay(%d, %q) = %v, want %v", tc.attempt, tc.retryAfter, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	cases := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusServiceUnavailable, true},
+	}
+
+	for _, tc := range cases {
+		if got := isRetryableStatus(tc.status); got != tc.want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", tc.status, got, tc.want)
+		}
+	}
+}
+
+func TestClientRetriesOnRateLimitThenSucceeds(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		if attempts == 1 {
+			w.Header().Set("X-Limit-User-Limit", "10")
+			w.Header().Set("X-Limit-User-Remaining", "0")
+			w.Header().Set("X-Limit-User-Reset", "60")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("X-Limit-User-Remaining", "9")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		"consumer-key",
+		WithBaseURL(server.URL),
+		WithRetry(RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if err := client.Add(context.Background(), AddInput{URL: "https://example.com", AccessToken: "token"}); err != nil {
+		t.Fatalf("Add() error = %v, want nil after retry", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("server received %d requests, want 2", attempts)
+	}
+
+	if got := client.LastRateLimit().UserRemaining; got != 9 {
+		t.Errorf("LastRateLimit().UserRemaining = %d, want 9", got)
+	}
+}
+
+func TestClientGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		"consumer-key",
+		WithBaseURL(server.URL),
+		WithRetry(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	err = client.Add(context.Background(), AddInput{URL: "https://example.com", AccessToken: "token"})
+	if err == nil {
+		t.Fatal("Add() error = nil, want error after exhausting retries")
+	}
+
+	if attempts != 3 {
+		t.Fatalf("server received %d requests, want 3 (1 initial + 2 retries)", attempts)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,11 +21,15 @@ const (
 	authorizeUrl = "https://getpocket.com/auth/authorize?request_token=%s&redirect_uri=%s"
 
 	endpointAdd          = "/add"
+	endpointGet          = "/get"
+	endpointSend         = "/send"
 	endpointRequestToken = "/oauth/request"
 	endpointAuthorize    = "/oauth/authorize"
 
 	// xErrorHeader used to parse error message from Headers on non-2XX responses
 	xErrorHeader = "X-Error"
+	// xErrorCodeHeader carries Pocket's numeric error code on non-2XX responses
+	xErrorCodeHeader = "X-Error-Code"
 
 	defaultTimeout = 5 * time.Second
 )
@@ -31,6 +37,12 @@ const (
 type Client struct {
 	client      *http.Client
 	consumerKey string
+	baseURL     string
+	userAgent   string
+	retryPolicy RetryPolicy
+
+	rateLimitMu sync.RWMutex
+	rateLimit   RateLimit
 }
 
 type requestTokenRequest struct {
@@ -64,17 +76,29 @@ type AddInput struct {
 	AccessToken string
 }
 
-func NewClient(consumerKey string) (*Client, error) {
+// NewClient creates a Pocket API client for the given consumer key. Behavior
+// such as the underlying *http.Client, timeout, user agent, base URL, and
+// retry policy can be customized via Option functions.
+func NewClient(consumerKey string, opts ...Option) (*Client, error) {
 	if consumerKey == "" {
 		return nil, errors.New("consumer key is empty")
 	}
 
-	return &Client{
+	c := &Client{
 		client: &http.Client{
 			Timeout: defaultTimeout,
 		},
 		consumerKey: consumerKey,
-	}, nil
+		baseURL:     host,
+		userAgent:   defaultUserAgent,
+		retryPolicy: defaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
 }
 
 // GetRequestToken obtains the request token that is used to authorize user in your application
@@ -172,40 +196,130 @@ func (c *Client) Add(ctx context.Context, input AddInput) error {
 }
 
 func (c *Client) doHTTP(ctx context.Context, endpoint string, body interface{}) (url.Values, error) {
-	b, err := json.Marshal(body)
+	responseBody, resp, err := c.send(ctx, endpoint, body, "")
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to marshal body")
+		return url.Values{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return url.Values{}, newAPIError(endpoint, resp)
 	}
 
-	newRequestURL := host + endpoint
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, newRequestURL, bytes.NewBuffer(b))
+	values, err := url.ParseQuery(string(responseBody))
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to create new request")
+		return url.Values{}, errors.WithMessage(err, "failed to parse response body")
 	}
 
-	req.Header.Set("Content-Type", "application/json; charset=UTF8")
+	return values, nil
+}
 
-	resp, err := c.client.Do(req)
+// doJSON behaves like doHTTP but asks Pocket to return a JSON body (via the
+// X-Accept header) and decodes it into out. It's used by endpoints such as
+// /v3/get whose response is a JSON object rather than a query string.
+func (c *Client) doJSON(ctx context.Context, endpoint string, body interface{}, out interface{}) error {
+	responseBody, resp, err := c.send(ctx, endpoint, body, "application/json")
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to send http request")
+		return err
 	}
 
-	defer resp.Body.Close()
-
 	if resp.StatusCode != http.StatusOK {
-		err := fmt.Sprintf("API Error: %s", resp.Header.Get(xErrorHeader))
-		return url.Values{}, errors.New(err)
+		return newAPIError(endpoint, resp)
+	}
+
+	if err := json.Unmarshal(responseBody, out); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal response body")
 	}
 
-	responseBody, err := ioutil.ReadAll(resp.Body)
+	return nil
+}
+
+// send marshals body, issues the request against endpoint, and retries on
+// rate-limited/unavailable responses according to c.retryPolicy. It records
+// the rate-limit headers from the last response before returning, so every
+// call updates what LastRateLimit reports, regardless of outcome.
+func (c *Client) send(ctx context.Context, endpoint string, body interface{}, accept string) ([]byte, *http.Response, error) {
+	b, err := json.Marshal(body)
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to read response body")
+		return nil, nil, errors.WithMessage(err, "failed to marshal body")
 	}
 
-	values, err := url.ParseQuery(string(responseBody))
+	requestURL := c.baseURL + endpoint
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(b))
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "failed to create new request")
+		}
+
+		req.Header.Set("Content-Type", "application/json; charset=UTF8")
+		req.Header.Set("User-Agent", c.userAgent)
+		if accept != "" {
+			req.Header.Set("X-Accept", accept)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "failed to send http request")
+		}
+
+		c.recordRateLimit(resp.Header)
+
+		if !isRetryableStatus(resp.StatusCode) || attempt >= c.retryPolicy.MaxRetries {
+			responseBody, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, nil, errors.WithMessage(err, "failed to read response body")
+			}
+
+			return responseBody, resp, nil
+		}
+
+		delay := c.retryPolicy.nextDelay(attempt, resp.Header.Get(headerRetryAfter))
+		resp.Body.Close()
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// recordRateLimit stores the X-Limit-* headers from resp so they're
+// available via LastRateLimit.
+func (c *Client) recordRateLimit(header http.Header) {
+	rl := RateLimit{
+		UserLimit:     parseHeaderInt(header.Get(headerLimitUserLimit)),
+		UserRemaining: parseHeaderInt(header.Get(headerLimitUserRemaining)),
+		UserReset:     parseHeaderInt(header.Get(headerLimitUserReset)),
+		KeyLimit:      parseHeaderInt(header.Get(headerLimitKeyLimit)),
+		KeyRemaining:  parseHeaderInt(header.Get(headerLimitKeyRemaining)),
+		KeyReset:      parseHeaderInt(header.Get(headerLimitKeyReset)),
+	}
+
+	c.rateLimitMu.Lock()
+	c.rateLimit = rl
+	c.rateLimitMu.Unlock()
+}
+
+// LastRateLimit returns the rate-limit values reported by the most recent
+// API response.
+func (c *Client) LastRateLimit() RateLimit {
+	c.rateLimitMu.RLock()
+	defer c.rateLimitMu.RUnlock()
+
+	return c.rateLimit
+}
+
+func parseHeaderInt(v string) int {
+	n, err := strconv.Atoi(v)
 	if err != nil {
-		return url.Values{}, errors.WithMessage(err, "failed to parse response body")
+		return 0
 	}
 
-	return values, nil
+	return n
 }